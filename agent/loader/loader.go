@@ -0,0 +1,124 @@
+// Package loader builds agent.Agent trees from declarative YAML/JSON specs
+// instead of hand-wired Go code, so prompts, sub-agent composition and tool
+// wiring can be iterated on without recompiling the binary that embeds them.
+package loader
+
+import (
+	"context"
+	"fmt"
+
+	veagent "github.com/volcengine/veadk-go/agent/llmagent"
+	"google.golang.org/adk/agent"
+	"google.golang.org/adk/agent/llmagent"
+	"google.golang.org/adk/tool"
+)
+
+// ToolFactory constructs a tool.Tool by name. Register one per tool with
+// RegisterTool before loading a spec that references it.
+type ToolFactory func() (tool.Tool, error)
+
+var toolFactories = map[string]ToolFactory{}
+
+// RegisterTool makes a tool available to the "tools" list in agent spec
+// files under the given name. It is typically called from an init() in the
+// package that implements the tool, alongside its existing constructor.
+func RegisterTool(name string, factory ToolFactory) {
+	toolFactories[name] = factory
+}
+
+// YAMLLoader is an agent.Loader that parses a single YAML or JSON spec file
+// into an agent.Agent tree on every Load call.
+type YAMLLoader struct {
+	path string
+}
+
+// NewYAMLLoader returns an agent.Loader that reads the agent graph from
+// path. path may be YAML or JSON; the format is detected from content, not
+// extension.
+func NewYAMLLoader(path string) agent.Loader {
+	return &YAMLLoader{path: path}
+}
+
+// Load implements agent.Loader.
+func (l *YAMLLoader) Load(ctx context.Context) (agent.Agent, error) {
+	spec, err := parseSpecFile(l.path)
+	if err != nil {
+		return nil, err
+	}
+	return Build(spec)
+}
+
+// configLoader is an agent.Loader wrapping an already-parsed Spec, for
+// callers that build or mutate specs programmatically rather than reading
+// them from disk.
+type configLoader struct {
+	spec *Spec
+}
+
+// NewConfigLoader returns an agent.Loader for a Spec built in code, e.g.
+// after patching one parsed with ParseBytes.
+func NewConfigLoader(spec *Spec) agent.Loader {
+	return &configLoader{spec: spec}
+}
+
+// Load implements agent.Loader.
+func (l *configLoader) Load(ctx context.Context) (agent.Agent, error) {
+	if err := l.spec.validate(); err != nil {
+		return nil, err
+	}
+	return Build(l.spec)
+}
+
+// ParseBytes parses an already-fetched YAML or JSON agent spec, e.g. one
+// pulled from an OCI artifact rather than read from local disk. sourceName
+// is used only for error messages. instruction_ref entries are resolved
+// relative to baseDir, which may be empty when the spec carries no external
+// references.
+func ParseBytes(data []byte, sourceName, baseDir string) (*Spec, error) {
+	return parseSpecBytes(data, sourceName, baseDir)
+}
+
+// Build turns a validated Spec into the agent.Agent tree equivalent to what
+// the hand-wired samples construct with veagent.New. Most callers should use
+// NewYAMLLoader or NewConfigLoader instead; Build is exported for loaders
+// that source a Spec some other way, such as registry/volccr.
+func Build(spec *Spec) (agent.Agent, error) {
+	tools := make([]tool.Tool, 0, len(spec.Tools))
+	for _, name := range spec.Tools {
+		factory, ok := toolFactories[name]
+		if !ok {
+			return nil, &SpecError{File: spec.file, Line: spec.line, Field: "tools", Err: fmt.Errorf("unregistered tool %q", name)}
+		}
+		t, err := factory()
+		if err != nil {
+			return nil, &SpecError{File: spec.file, Line: spec.line, Field: "tools", Err: fmt.Errorf("building tool %q: %w", name, err)}
+		}
+		tools = append(tools, t)
+	}
+
+	subAgents := make([]agent.Agent, 0, len(spec.SubAgents))
+	for _, sub := range spec.SubAgents {
+		built, err := Build(sub)
+		if err != nil {
+			return nil, err
+		}
+		subAgents = append(subAgents, built)
+	}
+
+	a, err := veagent.New(&veagent.Config{
+		Config: llmagent.Config{
+			Name:        spec.Name,
+			Description: spec.Description,
+			Instruction: spec.Instruction,
+			Tools:       tools,
+			SubAgents:   subAgents,
+		},
+		ModelName:    spec.Model,
+		ModelAPIBase: spec.ModelAPIBase,
+		ModelAPIKey:  spec.ModelAPIKey,
+	})
+	if err != nil {
+		return nil, &SpecError{File: spec.file, Line: spec.line, Field: "<agent>", Err: err}
+	}
+	return a, nil
+}
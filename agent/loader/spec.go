@@ -0,0 +1,191 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec describes a single agent node in a YAML/JSON agent graph. SubAgents
+// nest further Specs, mirroring how agent.Agent trees are built in Go today.
+type Spec struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description" json:"description"`
+
+	Model        string `yaml:"model" json:"model"`
+	ModelAPIBase string `yaml:"model_api_base" json:"model_api_base"`
+	ModelAPIKey  string `yaml:"model_api_key" json:"model_api_key"`
+
+	// Instruction is the literal prompt text. InstructionRef, if set, loads
+	// and takes precedence over Instruction so shared prompt fragments can
+	// be reused across multiple specs with a "$ref: path/to/fragment.md"
+	// style reference.
+	Instruction    string `yaml:"instruction" json:"instruction"`
+	InstructionRef string `yaml:"instruction_ref" json:"instruction_ref"`
+
+	Tools     []string `yaml:"tools" json:"tools"`
+	SubAgents []*Spec  `yaml:"sub_agents" json:"sub_agents"`
+
+	// file and line are populated while parsing so validation errors can
+	// point at the offending location.
+	file string
+	line int
+}
+
+var envVarPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-[^}]*)?\}`)
+
+// expandEnv expands "${VAR}" and "${VAR:-default}" references against the
+// process environment, matching the shell-style expansion ModelAPIKey and
+// other secret-bearing fields need.
+func expandEnv(s string) string {
+	return envVarPattern.ReplaceAllStringFunc(s, func(match string) string {
+		groups := envVarPattern.FindStringSubmatch(match)
+		name, def := groups[1], groups[2]
+		if v, ok := os.LookupEnv(name); ok {
+			return v
+		}
+		if def != "" {
+			return def[2:] // strip the leading ":-"
+		}
+		return ""
+	})
+}
+
+func (s *Spec) expandEnv() {
+	s.ModelAPIKey = expandEnv(s.ModelAPIKey)
+	s.ModelAPIBase = expandEnv(s.ModelAPIBase)
+	for _, sub := range s.SubAgents {
+		sub.expandEnv()
+	}
+}
+
+// resolveInstructionRefs reads InstructionRef files relative to baseDir and
+// substitutes their contents into Instruction.
+func (s *Spec) resolveInstructionRefs(baseDir string) error {
+	if s.InstructionRef != "" {
+		data, err := os.ReadFile(filepath.Join(baseDir, s.InstructionRef))
+		if err != nil {
+			return &SpecError{File: s.file, Line: s.line, Field: "instruction_ref", Err: err}
+		}
+		s.Instruction = string(data)
+	}
+	for _, sub := range s.SubAgents {
+		if err := sub.resolveInstructionRefs(baseDir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SpecError is returned by parsing and validation failures and carries the
+// file and, where known, the line of the offending spec node.
+type SpecError struct {
+	File  string
+	Line  int
+	Field string
+	Err   error
+}
+
+func (e *SpecError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s: %v", e.File, e.Line, e.Field, e.Err)
+	}
+	return fmt.Sprintf("%s: %s: %v", e.File, e.Field, e.Err)
+}
+
+func (e *SpecError) Unwrap() error { return e.Err }
+
+func (s *Spec) validate() error {
+	if s.Name == "" {
+		return &SpecError{File: s.file, Line: s.line, Field: "name", Err: fmt.Errorf("must not be empty")}
+	}
+	// A leaf agent needs some reason to exist: an instruction of its own, or
+	// at least one tool to act as a thin wrapper around (mirroring the
+	// original hand-wired weather_reporter, which has tools but no
+	// instruction). Agents with sub-agents lean on those instead.
+	if s.Instruction == "" && s.InstructionRef == "" && len(s.SubAgents) == 0 && len(s.Tools) == 0 {
+		return &SpecError{File: s.file, Line: s.line, Field: "instruction", Err: fmt.Errorf("agent %q has no instruction, tools or sub-agents", s.Name)}
+	}
+	for _, sub := range s.SubAgents {
+		if err := sub.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseSpecFile parses a YAML or JSON agent spec from path, recording
+// file+line information for every node so later validation errors are
+// actionable.
+func parseSpecFile(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("loader: read %s: %w", path, err)
+	}
+	return parseSpecBytes(data, path, filepath.Dir(path))
+}
+
+// parseSpecBytes parses a YAML or JSON agent spec already read into memory.
+// sourceName is used only for error messages; instruction_ref entries are
+// resolved relative to baseDir, which may be empty when the spec carries no
+// external references (e.g. a bundle pulled from a registry).
+func parseSpecBytes(data []byte, sourceName, baseDir string) (*Spec, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, &SpecError{File: sourceName, Field: "<root>", Err: err}
+	}
+
+	var spec Spec
+	if len(node.Content) > 0 {
+		if err := node.Content[0].Decode(&spec); err != nil {
+			return nil, &SpecError{File: sourceName, Line: node.Content[0].Line, Field: "<root>", Err: err}
+		}
+	}
+	annotateLines(&spec, &node, sourceName)
+
+	if err := spec.resolveInstructionRefs(baseDir); err != nil {
+		return nil, err
+	}
+	spec.expandEnv()
+
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// annotateLines walks the decoded yaml.Node tree in lock-step with the Spec
+// tree so every Spec knows which source line it came from.
+func annotateLines(spec *Spec, node *yaml.Node, path string) {
+	root := node
+	if len(node.Content) > 0 && node.Kind == yaml.DocumentNode {
+		root = node.Content[0]
+	}
+	spec.file = path
+	spec.line = root.Line
+
+	subAgentsNode := findMapValue(root, "sub_agents")
+	if subAgentsNode == nil {
+		return
+	}
+	for i, item := range subAgentsNode.Content {
+		if i < len(spec.SubAgents) {
+			annotateLines(spec.SubAgents[i], item, path)
+		}
+	}
+}
+
+func findMapValue(mapNode *yaml.Node, key string) *yaml.Node {
+	if mapNode.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(mapNode.Content); i += 2 {
+		if mapNode.Content[i].Value == key {
+			return mapNode.Content[i+1]
+		}
+	}
+	return nil
+}
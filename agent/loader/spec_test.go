@@ -0,0 +1,66 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("VEADK_TEST_API_KEY", "sk-123")
+
+	cases := map[string]string{
+		"${VEADK_TEST_API_KEY}":         "sk-123",
+		"${VEADK_TEST_UNSET:-fallback}": "fallback",
+		"${VEADK_TEST_UNSET}":           "",
+		"prefix-${VEADK_TEST_API_KEY}":  "prefix-sk-123",
+		"no references here":            "no references here",
+	}
+
+	for in, want := range cases {
+		if got := expandEnv(in); got != want {
+			t.Errorf("expandEnv(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestResolveInstructionRefs(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "fragment.md"), []byte("shared prompt text"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	spec := &Spec{Name: "root", InstructionRef: "fragment.md"}
+	if err := spec.resolveInstructionRefs(dir); err != nil {
+		t.Fatalf("resolveInstructionRefs: %v", err)
+	}
+	if spec.Instruction != "shared prompt text" {
+		t.Errorf("Instruction = %q, want %q", spec.Instruction, "shared prompt text")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		spec    *Spec
+		wantErr bool
+	}{
+		{"missing name", &Spec{}, true},
+		{"instruction only", &Spec{Name: "a", Instruction: "do things"}, false},
+		{"tools only, no instruction", &Spec{Name: "weather_reporter", Tools: []string{"get_city_weather"}}, false},
+		{"sub-agents only, no instruction", &Spec{Name: "planner", SubAgents: []*Spec{{Name: "child", Instruction: "x"}}}, false},
+		{"nothing at all", &Spec{Name: "empty"}, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.spec.validate()
+			if tc.wantErr && err == nil {
+				t.Fatalf("validate() = nil, want error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("validate() = %v, want nil", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Command tool-server hosts one or more tools behind the ToolBackend gRPC
+// service defined in tool/grpc, so they can run as an independently
+// deployable, independently scaled process instead of being compiled into
+// the agent binary. It ships with the reference web_search backend wired
+// in; register further tools in main as they're migrated.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"github.com/volcengine/veadk-go/tool/builtin_tools/web_search"
+	vetoolgrpc "github.com/volcengine/veadk-go/tool/grpc"
+	"github.com/volcengine/veadk-go/tool/grpc/websearch"
+)
+
+func main() {
+	addr := flag.String("addr", ":7890", "address to listen for ToolBackend gRPC clients on")
+	flag.Parse()
+
+	srv, err := websearch.NewServer(&web_search.Config{})
+	if err != nil {
+		log.Fatalf("tool-server: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("tool-server: listen %s: %v", *addr, err)
+	}
+
+	s := vetoolgrpc.NewGRPCServer()
+	srv.Register(s)
+
+	log.Printf("tool-server: listening on %s", *addr)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("tool-server: serve: %v", err)
+	}
+}
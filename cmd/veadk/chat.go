@@ -0,0 +1,60 @@
+package veadk
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/adk/agent"
+	"google.golang.org/adk/cmd/launcher"
+	"google.golang.org/adk/runner"
+)
+
+func newChatCmd(config *launcher.Config) *cobra.Command {
+	var message string
+
+	cmd := &cobra.Command{
+		Use:   "chat",
+		Short: "One-shot REPL against the loaded root agent",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root, err := config.AgentLoader.Load(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("chat: load agent tree: %w", err)
+			}
+			r := runner.New(root, config.SessionService)
+
+			if message != "" {
+				return runTurn(cmd, r, root, message)
+			}
+
+			scanner := bufio.NewScanner(os.Stdin)
+			fmt.Fprintf(cmd.OutOrStdout(), "chatting with %s, Ctrl-D to exit\n", root.Name())
+			for {
+				fmt.Fprint(cmd.OutOrStdout(), "> ")
+				if !scanner.Scan() {
+					return scanner.Err()
+				}
+				if err := runTurn(cmd, r, root, scanner.Text()); err != nil {
+					fmt.Fprintln(cmd.ErrOrStderr(), err)
+				}
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&message, "message", "", "send a single message and exit instead of starting an interactive REPL")
+	return cmd
+}
+
+func runTurn(cmd *cobra.Command, r *runner.Runner, root agent.Agent, text string) error {
+	events, err := r.Run(cmd.Context(), root, &runner.Input{Text: text})
+	if err != nil {
+		return fmt.Errorf("chat: %w", err)
+	}
+	for _, ev := range events {
+		if ev.Text != "" {
+			fmt.Fprintln(cmd.OutOrStdout(), ev.Text)
+		}
+	}
+	return nil
+}
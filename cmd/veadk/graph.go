@@ -0,0 +1,70 @@
+package veadk
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/adk/agent"
+	"google.golang.org/adk/cmd/launcher"
+)
+
+type graphNode struct {
+	Name      string       `json:"name"`
+	Tools     []string     `json:"tools,omitempty"`
+	SubAgents []*graphNode `json:"sub_agents,omitempty"`
+}
+
+func newGraphCmd(config *launcher.Config) *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Dump the agent/sub-agent/tool topology as DOT or JSON",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root, err := config.AgentLoader.Load(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("graph: load agent tree: %w", err)
+			}
+			node := walkGraph(root)
+
+			switch format {
+			case "json":
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(node)
+			case "dot":
+				fmt.Fprintln(cmd.OutOrStdout(), "digraph agents {")
+				writeDOT(cmd, node)
+				fmt.Fprintln(cmd.OutOrStdout(), "}")
+				return nil
+			default:
+				return fmt.Errorf("graph: unknown --format %q, want \"dot\" or \"json\"", format)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "dot", `output format: "dot" or "json"`)
+	return cmd
+}
+
+func walkGraph(a agent.Agent) *graphNode {
+	node := &graphNode{Name: a.Name()}
+	for _, t := range a.Tools() {
+		node.Tools = append(node.Tools, t.(interface{ Name() string }).Name())
+	}
+	for _, sub := range a.SubAgents() {
+		node.SubAgents = append(node.SubAgents, walkGraph(sub))
+	}
+	return node
+}
+
+func writeDOT(cmd *cobra.Command, node *graphNode) {
+	for _, tool := range node.Tools {
+		fmt.Fprintf(cmd.OutOrStdout(), "  %q -> %q [style=dashed];\n", node.Name, tool)
+	}
+	for _, sub := range node.SubAgents {
+		fmt.Fprintf(cmd.OutOrStdout(), "  %q -> %q;\n", node.Name, sub.Name)
+		writeDOT(cmd, sub)
+	}
+}
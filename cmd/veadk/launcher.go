@@ -0,0 +1,73 @@
+// Package veadk wraps full.NewLauncher in a cobra command tree, replacing
+// the single os.Args[1:] parse every sample main.go used to hand-roll with
+// per-subcommand flags: serve, chat, graph, validate and tool.
+package veadk
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/adk/cmd/launcher"
+)
+
+// Launcher mirrors full.Launcher's construction and Execute signature so it
+// can be substituted directly in existing main.go files, including
+// returning any failure to the caller rather than exiting the process
+// itself.
+type Launcher struct {
+	root *cobra.Command
+}
+
+// NewLauncher returns a Launcher whose Execute runs a cobra command tree
+// instead of full.Launcher's single-parse CLI.
+func NewLauncher() *Launcher {
+	return &Launcher{}
+}
+
+// Execute builds the serve/chat/graph/validate/tool command tree bound to
+// config, parses args against it and runs the selected subcommand. With no
+// subcommand, and on "serve" itself, flag parsing is disabled and args are
+// forwarded verbatim to full.Launcher so existing invocations that pass the
+// native launcher's own flags directly keep working.
+func (l *Launcher) Execute(ctx context.Context, config *launcher.Config, args []string) error {
+	l.root = newRootCommand(config)
+	l.root.SetArgs(args)
+	return l.root.ExecuteContext(ctx)
+}
+
+// CommandLineSyntax matches full.Launcher's fallback error-reporting
+// convention.
+func (l *Launcher) CommandLineSyntax() string {
+	if l.root == nil {
+		l.root = newRootCommand(nil)
+	}
+	return l.root.UsageString()
+}
+
+func newRootCommand(config *launcher.Config) *cobra.Command {
+	serveCmd := newServeCmd(config)
+
+	root := &cobra.Command{
+		Use: "veadk",
+		Short: "Operate a veadk agent launcher",
+		// Print the failing error (cobra's default behavior) but not the
+		// full usage block; SilenceUsage on the root suppresses usage for
+		// every error, subcommand flag-parsing mistakes included.
+		SilenceUsage: true,
+		// Flag parsing is disabled here so a bare native launcher flag
+		// (e.g. "myagent --addr=:9090", the old os.Args[1:] passthrough)
+		// reaches full.Launcher via serveCmd.RunE instead of failing
+		// cobra's "unknown flag" check.
+		DisableFlagParsing: true,
+		RunE:               serveCmd.RunE,
+	}
+
+	root.AddCommand(
+		serveCmd,
+		newChatCmd(config),
+		newGraphCmd(config),
+		newValidateCmd(config),
+		newToolCmd(config),
+	)
+	return root
+}
@@ -0,0 +1,27 @@
+package veadk
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/adk/cmd/launcher"
+	"google.golang.org/adk/cmd/launcher/full"
+)
+
+func newServeCmd(config *launcher.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve [launcher flags]",
+		Short: "Start the agent launcher (the default when no subcommand is given)",
+		// The flags here belong to full.Launcher, not this command, so cobra
+		// must not try to parse them itself.
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := full.NewLauncher()
+			if err := l.Execute(cmd.Context(), config, args); err != nil {
+				return fmt.Errorf("%w\n\n%s", err, l.CommandLineSyntax())
+			}
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,78 @@
+package veadk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/adk/agent"
+	"google.golang.org/adk/cmd/launcher"
+)
+
+func newToolCmd(config *launcher.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tool",
+		Short: "Inspect and invoke tools wired into the loaded agent tree",
+	}
+	cmd.AddCommand(newToolCallCmd(config))
+	return cmd
+}
+
+func newToolCallCmd(config *launcher.Config) *cobra.Command {
+	var argsJSON, sessionID, userID string
+
+	cmd := &cobra.Command{
+		Use:   "call <name>",
+		Short: "Invoke a single tool with JSON args for debugging",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			root, err := config.AgentLoader.Load(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("tool call: load agent tree: %w", err)
+			}
+
+			t, err := findTool(root, name)
+			if err != nil {
+				return err
+			}
+
+			caller, ok := t.(interface {
+				Call(ctx context.Context, sessionID, userID string, args json.RawMessage) (json.RawMessage, error)
+			})
+			if !ok {
+				return fmt.Errorf("tool call: %q does not support direct invocation", name)
+			}
+
+			result, err := caller.Call(cmd.Context(), sessionID, userID, json.RawMessage(argsJSON))
+			if err != nil {
+				return fmt.Errorf("tool call: %w", err)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(result))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&argsJSON, "args", "{}", "JSON-encoded tool arguments")
+	cmd.Flags().StringVar(&sessionID, "session-id", "veadk-cli", "session ID passed to the tool")
+	cmd.Flags().StringVar(&userID, "user-id", "veadk-cli", "user ID passed to the tool")
+	return cmd
+}
+
+// findTool searches a for a tool named name, recursing into sub-agents.
+func findTool(a agent.Agent, name string) (interface{ Name() string }, error) {
+	for _, t := range a.Tools() {
+		named := t.(interface{ Name() string })
+		if named.Name() == name {
+			return named, nil
+		}
+	}
+	for _, sub := range a.SubAgents() {
+		if t, err := findTool(sub, name); err == nil {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("tool call: no tool named %q in the loaded agent tree", name)
+}
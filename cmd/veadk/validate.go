@@ -0,0 +1,23 @@
+package veadk
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/adk/cmd/launcher"
+)
+
+func newValidateCmd(config *launcher.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Dry-run the agent loader without starting the server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root, err := config.AgentLoader.Load(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("validate: %w", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "ok: loaded agent tree rooted at %q\n", root.Name())
+			return nil
+		},
+	}
+}
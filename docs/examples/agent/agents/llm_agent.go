@@ -7,11 +7,11 @@ import (
 	"os"
 
 	veagent "github.com/volcengine/veadk-go/agent/llmagent"
+	"github.com/volcengine/veadk-go/cmd/veadk"
 	vetool "github.com/volcengine/veadk-go/tool"
 	"google.golang.org/adk/agent"
 	"google.golang.org/adk/agent/llmagent"
 	"google.golang.org/adk/cmd/launcher"
-	"google.golang.org/adk/cmd/launcher/full"
 	"google.golang.org/adk/session"
 	"google.golang.org/adk/tool"
 )
@@ -70,7 +70,7 @@ func main() {
 		SessionService: session.InMemoryService(),
 	}
 
-	l := full.NewLauncher()
+	l := veadk.NewLauncher()
 	if err = l.Execute(ctx, config, os.Args[1:]); err != nil {
 		log.Fatalf("Run failed: %v\n\n%s", err, l.CommandLineSyntax())
 	}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/volcengine/veadk-go/agent/loader"
+	vetool "github.com/volcengine/veadk-go/tool"
+	"google.golang.org/adk/cmd/launcher"
+	"google.golang.org/adk/cmd/launcher/full"
+	"google.golang.org/adk/session"
+	"google.golang.org/adk/tool"
+)
+
+func init() {
+	loader.RegisterTool("get_city_weather", func() (tool.Tool, error) {
+		return vetool.GetCityWeatherTool()
+	})
+}
+
+func main() {
+	ctx := context.Background()
+
+	config := &launcher.Config{
+		AgentLoader:    loader.NewYAMLLoader("agents.yaml"),
+		SessionService: session.InMemoryService(),
+	}
+
+	l := full.NewLauncher()
+	if err := l.Execute(ctx, config, os.Args[1:]); err != nil {
+		log.Fatalf("Run failed: %v\n\n%s", err, l.CommandLineSyntax())
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	veagent "github.com/volcengine/veadk-go/agent/llmagent"
+	"github.com/volcengine/veadk-go/common"
+	veopenai "github.com/volcengine/veadk-go/launcher/openai"
+	"github.com/volcengine/veadk-go/utils"
+	"google.golang.org/adk/agent"
+	"google.golang.org/adk/cmd/launcher"
+	"google.golang.org/adk/session"
+)
+
+func main() {
+	ctx := context.Background()
+
+	rootAgent, err := veagent.New(&veagent.Config{
+		ModelName:    common.DEFAULT_MODEL_AGENT_NAME,
+		ModelAPIBase: common.DEFAULT_MODEL_AGENT_API_BASE,
+		ModelAPIKey:  utils.GetEnvWithDefault(common.MODEL_AGENT_API_KEY),
+	})
+	if err != nil {
+		log.Fatalf("Failed to create agent: %v", err)
+	}
+
+	config := &veopenai.Config{
+		Config: launcher.Config{
+			AgentLoader:    agent.NewSingleLoader(rootAgent),
+			SessionService: session.InMemoryService(),
+		},
+		Addr: ":8080",
+	}
+
+	l := veopenai.NewLauncher()
+	if err := l.Execute(ctx, config, nil); err != nil {
+		log.Fatalf("Run failed: %v\n\n%s", err, l.CommandLineSyntax())
+	}
+}
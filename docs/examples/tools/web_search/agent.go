@@ -6,12 +6,12 @@ import (
 	"os"
 
 	veagent "github.com/volcengine/veadk-go/agent/llmagent"
+	"github.com/volcengine/veadk-go/cmd/veadk"
 	"github.com/volcengine/veadk-go/common"
 	"github.com/volcengine/veadk-go/tool/builtin_tools/web_search"
 	"github.com/volcengine/veadk-go/utils"
 	"google.golang.org/adk/agent"
 	"google.golang.org/adk/cmd/launcher"
-	"google.golang.org/adk/cmd/launcher/full"
 	"google.golang.org/adk/session"
 	"google.golang.org/adk/tool"
 )
@@ -47,7 +47,7 @@ func main() {
 		SessionService: sessionService,
 	}
 
-	l := full.NewLauncher()
+	l := veadk.NewLauncher()
 	if err = l.Execute(ctx, config, os.Args[1:]); err != nil {
 		log.Fatalf("Run failed: %v\n\n%s", err, l.CommandLineSyntax())
 	}
@@ -0,0 +1,226 @@
+// Package openai exposes a loaded agent tree through the OpenAI chat,
+// completions and models HTTP surface, so any OpenAI-compatible client
+// (LangChain, LlamaIndex, curl scripts, ...) can drive it without code
+// changes on the client side.
+package openai
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/adk/agent"
+	"google.golang.org/adk/runner"
+	"google.golang.org/adk/session"
+)
+
+// Handler serves the OpenAI-compatible endpoints for a loaded agent tree.
+// Construct one with NewHandler and mount it directly, or run it via
+// Launcher.
+type Handler struct {
+	root   agent.Agent
+	agents map[string]agent.Agent
+	runner *runner.Runner
+	mux    *http.ServeMux
+}
+
+// NewHandler indexes root and every sub-agent reachable from it by name and
+// returns a Handler ready to serve /v1/chat/completions, /v1/completions
+// and /v1/models.
+func NewHandler(root agent.Agent, sessionService session.Service) *Handler {
+	h := &Handler{
+		root:   root,
+		agents: map[string]agent.Agent{},
+		runner: runner.New(root, sessionService),
+	}
+	h.index(root)
+
+	h.mux = http.NewServeMux()
+	h.mux.HandleFunc("/v1/chat/completions", h.handleChatCompletions)
+	h.mux.HandleFunc("/v1/completions", h.handleCompletions)
+	h.mux.HandleFunc("/v1/models", h.handleModels)
+	return h
+}
+
+func (h *Handler) index(a agent.Agent) {
+	h.agents[a.Name()] = a
+	for _, sub := range a.SubAgents() {
+		h.index(sub)
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// resolve maps a request's "model" field (an agent.Name) to the agent that
+// should handle it, defaulting to the root agent.
+func (h *Handler) resolve(model string) agent.Agent {
+	if a, ok := h.agents[model]; ok {
+		return a
+	}
+	return h.root
+}
+
+func (h *Handler) handleModels(w http.ResponseWriter, r *http.Request) {
+	models := make([]Model, 0, len(h.agents))
+	for name := range h.agents {
+		models = append(models, Model{ID: name, Object: "model", OwnedBy: "veadk"})
+	}
+	writeJSON(w, http.StatusOK, ModelsResponse{Object: "list", Data: models})
+}
+
+func (h *Handler) handleChatCompletions(w http.ResponseWriter, r *http.Request) {
+	var req ChatCompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	target := h.resolve(req.Model)
+	prompt := lastUserMessage(req.Messages)
+
+	events, err := h.runner.Run(r.Context(), target, &runner.Input{Text: prompt})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if !req.Stream {
+		msg := collapseToMessage(events, req.Verbose)
+		writeJSON(w, http.StatusOK, chunkFor(req.Model, msg, true))
+		return
+	}
+
+	streamSSE(w, req.Model, events, req.Verbose)
+}
+
+func (h *Handler) handleCompletions(w http.ResponseWriter, r *http.Request) {
+	var req CompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	target := h.resolve(req.Model)
+	events, err := h.runner.Run(r.Context(), target, &runner.Input{Text: req.Prompt})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	msg := collapseToMessage(events, false)
+	writeJSON(w, http.StatusOK, CompletionResponse{
+		Object: "text_completion",
+		Model:  req.Model,
+		Choices: []CompletionChoice{
+			{Text: msg.Content, FinishReason: strPtr("stop")},
+		},
+	})
+}
+
+// lastUserMessage returns the content of the final "user" message, which is
+// what gets forwarded as the agent turn's input.
+func lastUserMessage(messages []Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == "user" {
+			return messages[i].Content
+		}
+	}
+	return ""
+}
+
+// collapseToMessage drains events into one assistant Message, translating
+// tool invocations into OpenAI tool_calls blocks. The text of every event is
+// concatenated into Content. When verbose is true, each sub-agent transfer
+// also adds a "[transferred to <agent>]" line; when false, transfer events
+// are skipped.
+func collapseToMessage(events []*runner.Event, verbose bool) Message {
+	var sb strings.Builder
+	var calls []ToolCall
+
+	for _, ev := range events {
+		if ev.Transfer != nil && verbose {
+			fmt.Fprintf(&sb, "[transferred to %s]\n", ev.Transfer.ToAgent)
+		}
+		if ev.ToolCall != nil {
+			calls = append(calls, ToolCall{
+				ID:   ev.ToolCall.ID,
+				Type: "function",
+				Function: ToolCallFunc{
+					Name:      ev.ToolCall.Name,
+					Arguments: ev.ToolCall.ArgumentsJSON,
+				},
+			})
+		}
+		if ev.Text != "" {
+			sb.WriteString(ev.Text)
+		}
+	}
+
+	return Message{Role: "assistant", Content: sb.String(), ToolCalls: calls}
+}
+
+// chunkFor builds the single-choice response real OpenAI clients expect.
+// Non-streamed responses (final=true) carry the message on Choices[].Message
+// under object "chat.completion", which is the only field openai-python/
+// LangChain/etc. read for POST /v1/chat/completions; streamed SSE chunks
+// carry it on Choices[].Delta under object "chat.completion.chunk" instead.
+func chunkFor(model string, msg Message, final bool) ChatCompletionChunk {
+	choice := ChatCompletionChoice{Index: 0}
+	object := "chat.completion.chunk"
+	if final {
+		choice.Message = &msg
+		choice.FinishReason = strPtr("stop")
+		object = "chat.completion"
+	} else {
+		choice.Delta = msg
+	}
+
+	return ChatCompletionChunk{
+		Object:  object,
+		Model:   model,
+		Choices: []ChatCompletionChoice{choice},
+	}
+}
+
+func streamSSE(w http.ResponseWriter, model string, events []*runner.Event, verbose bool) {
+	flusher, _ := w.(http.Flusher)
+	w.Header().Set("Content-Type", "text/event-stream")
+	bw := bufio.NewWriter(w)
+
+	for _, ev := range events {
+		msg := collapseToMessage([]*runner.Event{ev}, verbose)
+		if msg.Content == "" && len(msg.ToolCalls) == 0 {
+			continue
+		}
+		data, _ := json.Marshal(chunkFor(model, msg, false))
+		fmt.Fprintf(bw, "data: %s\n\n", data)
+		bw.Flush()
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	fmt.Fprint(bw, "data: [DONE]\n\n")
+	bw.Flush()
+	if flusher != nil {
+		flusher.Flush()
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]any{
+		"error": map[string]string{"message": err.Error()},
+	})
+}
+
+func strPtr(s string) *string { return &s }
@@ -0,0 +1,68 @@
+package openai
+
+import (
+	"testing"
+
+	"google.golang.org/adk/runner"
+)
+
+func TestLastUserMessage(t *testing.T) {
+	messages := []Message{
+		{Role: "system", Content: "be helpful"},
+		{Role: "user", Content: "first question"},
+		{Role: "assistant", Content: "first answer"},
+		{Role: "user", Content: "second question"},
+	}
+	if got := lastUserMessage(messages); got != "second question" {
+		t.Errorf("lastUserMessage() = %q, want %q", got, "second question")
+	}
+}
+
+func TestCollapseToMessage(t *testing.T) {
+	events := []*runner.Event{
+		{Transfer: &runner.Transfer{ToAgent: "weather_reporter"}},
+		{Text: "it's 20C in Shenzhen"},
+		{ToolCall: &runner.ToolCall{ID: "call_1", Name: "get_city_weather", ArgumentsJSON: `{"city":"Shenzhen"}`}},
+	}
+
+	quiet := collapseToMessage(events, false)
+	if quiet.Content != "it's 20C in Shenzhen" {
+		t.Errorf("non-verbose content = %q, want only the text event", quiet.Content)
+	}
+	if len(quiet.ToolCalls) != 1 || quiet.ToolCalls[0].Function.Name != "get_city_weather" {
+		t.Errorf("tool calls = %+v, want one get_city_weather call", quiet.ToolCalls)
+	}
+
+	verbose := collapseToMessage(events, true)
+	if verbose.Content == quiet.Content {
+		t.Errorf("verbose content should also mention the transfer, got %q", verbose.Content)
+	}
+}
+
+func TestChunkFor(t *testing.T) {
+	msg := Message{Role: "assistant", Content: "hello"}
+
+	final := chunkFor("planner", msg, true)
+	if final.Object != "chat.completion" {
+		t.Errorf("final chunk Object = %q, want %q", final.Object, "chat.completion")
+	}
+	choice := final.Choices[0]
+	if choice.Message == nil || choice.Message.Content != "hello" {
+		t.Fatalf("final chunk Message = %+v, want populated with %q", choice.Message, "hello")
+	}
+	if choice.FinishReason == nil || *choice.FinishReason != "stop" {
+		t.Errorf("final chunk FinishReason = %v, want \"stop\"", choice.FinishReason)
+	}
+
+	streamed := chunkFor("planner", msg, false)
+	if streamed.Object != "chat.completion.chunk" {
+		t.Errorf("streamed chunk Object = %q, want %q", streamed.Object, "chat.completion.chunk")
+	}
+	streamedChoice := streamed.Choices[0]
+	if streamedChoice.Message != nil {
+		t.Errorf("streamed chunk Message = %+v, want nil", streamedChoice.Message)
+	}
+	if streamedChoice.Delta.Content != "hello" {
+		t.Errorf("streamed chunk Delta.Content = %q, want %q", streamedChoice.Delta.Content, "hello")
+	}
+}
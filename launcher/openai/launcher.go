@@ -0,0 +1,69 @@
+package openai
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/adk/cmd/launcher"
+)
+
+// Config extends launcher.Config with the address the OpenAI-compatible
+// HTTP surface listens on.
+type Config struct {
+	launcher.Config
+
+	// Addr is the address Launcher.Execute listens on, e.g. ":8080".
+	Addr string
+}
+
+// Launcher runs a loaded agent tree behind the OpenAI-compatible HTTP
+// surface instead of the native veadk launcher protocol, so existing
+// OpenAI clients can talk to it unmodified.
+type Launcher struct{}
+
+// NewLauncher returns a Launcher, mirroring full.NewLauncher's signature so
+// it can be substituted wherever a *full.Launcher is constructed.
+func NewLauncher() *Launcher {
+	return &Launcher{}
+}
+
+// Execute loads the agent tree from config.AgentLoader and serves it on
+// config.Addr, or on the --addr flag in args when given, until ctx is done
+// or the server fails.
+func (l *Launcher) Execute(ctx context.Context, config *Config, args []string) error {
+	fs := flag.NewFlagSet("openai", flag.ContinueOnError)
+	addr := fs.String("addr", config.Addr, "address to serve the OpenAI-compatible HTTP surface on")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("openai: %w", err)
+	}
+	config.Addr = *addr
+	if config.Addr == "" {
+		config.Addr = ":8080"
+	}
+
+	root, err := config.AgentLoader.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("openai: load agent tree: %w", err)
+	}
+
+	handler := NewHandler(root, config.SessionService)
+
+	server := &http.Server{Addr: config.Addr, Handler: handler}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("openai: serve %s: %w", config.Addr, err)
+	}
+	return nil
+}
+
+// CommandLineSyntax matches full.Launcher's error-reporting convention so
+// callers can fall back to it identically.
+func (l *Launcher) CommandLineSyntax() string {
+	return "usage: <binary> [--addr=:8080]"
+}
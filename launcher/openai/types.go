@@ -0,0 +1,94 @@
+package openai
+
+// Message is an OpenAI chat message. Role is one of "system", "user",
+// "assistant" or "tool".
+type Message struct {
+	Role       string     `json:"role"`
+	Content    string     `json:"content,omitempty"`
+	Name       string     `json:"name,omitempty"`
+	ToolCallID string     `json:"tool_call_id,omitempty"`
+	ToolCalls  []ToolCall `json:"tool_calls,omitempty"`
+}
+
+// ToolCall is an OpenAI tool_calls entry on an assistant message.
+type ToolCall struct {
+	ID       string       `json:"id"`
+	Type     string       `json:"type"`
+	Function ToolCallFunc `json:"function"`
+}
+
+type ToolCallFunc struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// ChatCompletionRequest is the body of POST /v1/chat/completions. Model is
+// mapped to an agent.Name; when it does not match any loaded agent the root
+// agent handles the request.
+type ChatCompletionRequest struct {
+	Model    string    `json:"model"`
+	Messages []Message `json:"messages"`
+	Stream   bool      `json:"stream"`
+	// Verbose is a veadk extension: when true, each multi-agent transfer is
+	// surfaced as a "[transferred to <agent>]" line of assistant content (its
+	// own chunk when streaming); when false, transfers are not shown.
+	Verbose bool `json:"verbose,omitempty"`
+}
+
+// ChatCompletionChunk is one SSE "data:" payload of a streamed chat
+// completion response.
+type ChatCompletionChunk struct {
+	ID      string                 `json:"id"`
+	Object  string                 `json:"object"`
+	Created int64                  `json:"created"`
+	Model   string                 `json:"model"`
+	Choices []ChatCompletionChoice `json:"choices"`
+}
+
+type ChatCompletionChoice struct {
+	Index int `json:"index"`
+	// Message is populated on the non-streamed response; Delta is populated
+	// on each streamed SSE chunk. Real OpenAI clients only read one or the
+	// other depending on whether they asked for Stream.
+	Message      *Message `json:"message,omitempty"`
+	Delta        Message  `json:"delta,omitempty"`
+	FinishReason *string  `json:"finish_reason"`
+}
+
+// CompletionRequest is the body of POST /v1/completions.
+type CompletionRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream"`
+}
+
+// CompletionResponse is the body of a non-streamed POST /v1/completions
+// response.
+type CompletionResponse struct {
+	ID      string             `json:"id"`
+	Object  string             `json:"object"`
+	Created int64              `json:"created"`
+	Model   string             `json:"model"`
+	Choices []CompletionChoice `json:"choices"`
+}
+
+type CompletionChoice struct {
+	Index        int     `json:"index"`
+	Text         string  `json:"text"`
+	FinishReason *string `json:"finish_reason"`
+}
+
+// Model is one entry of GET /v1/models, one per agent reachable from the
+// loaded root agent.
+type Model struct {
+	ID      string `json:"id"`
+	Object  string `json:"object"`
+	Created int64  `json:"created"`
+	OwnedBy string `json:"owned_by"`
+}
+
+// ModelsResponse is the body of GET /v1/models.
+type ModelsResponse struct {
+	Object string  `json:"object"`
+	Data   []Model `json:"data"`
+}
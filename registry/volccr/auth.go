@@ -0,0 +1,44 @@
+package volccr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/volcengine/volcengine-go-sdk/service/cr"
+	"github.com/volcengine/volcengine-go-sdk/volcengine"
+	"github.com/volcengine/volcengine-go-sdk/volcengine/session"
+)
+
+// tokenSource discovers a bearer token for pulling blobs from a Volcengine
+// CR instance, using the same credential chain (env vars, instance
+// metadata, shared config) as other veadk-go integrations.
+type tokenSource struct {
+	client *cr.CR
+}
+
+// newTokenSource builds a CR client whose session targets region, so token
+// requests and the underlying API calls hit the regional endpoint implied
+// by the pulled image's registry host rather than the SDK's default.
+func newTokenSource(region string) (*tokenSource, error) {
+	sess, err := session.NewSession(&volcengine.Config{Region: volcengine.String(region)})
+	if err != nil {
+		return nil, fmt.Errorf("volccr: new session: %w", err)
+	}
+	return &tokenSource{client: cr.New(sess)}, nil
+}
+
+// bearerToken exchanges the ambient credentials for a short-lived OCI
+// distribution bearer token scoped to repo.
+func (t *tokenSource) bearerToken(ctx context.Context, registry, repo string) (string, error) {
+	input := &cr.GetAuthorizationTokenInput{
+		Registry: &registry,
+		Scope:    strPtr(fmt.Sprintf("repository:%s:pull", repo)),
+	}
+	out, err := t.client.GetAuthorizationTokenWithContext(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("volccr: get authorization token: %w", err)
+	}
+	return *out.Token, nil
+}
+
+func strPtr(s string) *string { return &s }
@@ -0,0 +1,45 @@
+package volccr
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// diskCache stores pulled blobs on disk, keyed by their content digest, so
+// repeated boots of the same pinned tag skip re-downloading layer blobs. The
+// bearer token and the image manifest are still fetched from the registry
+// on every Load.
+type diskCache struct {
+	dir string
+}
+
+func newDiskCache(dir string) (*diskCache, error) {
+	if dir == "" {
+		cacheRoot, err := os.UserCacheDir()
+		if err != nil {
+			return nil, fmt.Errorf("volccr: resolve cache dir: %w", err)
+		}
+		dir = filepath.Join(cacheRoot, "veadk", "volccr")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("volccr: create cache dir %s: %w", dir, err)
+	}
+	return &diskCache{dir: dir}, nil
+}
+
+func (c *diskCache) path(digest string) string {
+	return filepath.Join(c.dir, digest)
+}
+
+func (c *diskCache) get(digest string) ([]byte, bool) {
+	data, err := os.ReadFile(c.path(digest))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+func (c *diskCache) put(digest string, data []byte) error {
+	return os.WriteFile(c.path(digest), data, 0o644)
+}
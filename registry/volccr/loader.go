@@ -0,0 +1,205 @@
+// Package volccr pulls a versioned agent bundle — an agent graph spec plus
+// its tool binaries or gRPC endpoints — from Volcengine Container Registry
+// and loads it the same way agent.NewSingleLoader loads a hand-built
+// agent.Agent, so a production launcher can boot by registry+repo+tag
+// rather than being rebuilt whenever the agent graph changes.
+//
+// Load pulls only the bundle's manifest and agent spec layers. The
+// manifest's Tools entries are decoded but not acted on: no tool binary is
+// pulled and no gRPC endpoint is dialed, and tools named in the spec are
+// resolved through loader.RegisterTool as for a spec read from disk.
+package volccr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/volcengine/veadk-go/agent/loader"
+	"google.golang.org/adk/agent"
+)
+
+const (
+	manifestMediaType  = "application/vnd.veadk.agent-bundle.manifest.v1+json"
+	specMediaType      = "application/vnd.veadk.agent-bundle.spec.v1+yaml"
+	signatureMediaType = "application/vnd.veadk.agent-bundle.signature.v1+octet-stream"
+)
+
+// Option configures a Loader.
+type Option func(*Loader)
+
+// WithCacheDir overrides the on-disk cache directory. The default is
+// os.UserCacheDir()/veadk/volccr.
+func WithCacheDir(dir string) Option {
+	return func(l *Loader) { l.cacheDir = dir }
+}
+
+// WithDigest pins the pull to a specific content digest in addition to tag,
+// failing the load if the registry serves something else under that tag.
+func WithDigest(digest string) Option {
+	return func(l *Loader) { l.pinnedDigest = digest }
+}
+
+// WithSignatureVerifier requires every pulled manifest to pass verifier
+// before it is loaded.
+func WithSignatureVerifier(verifier SignatureVerifier) Option {
+	return func(l *Loader) { l.verifier = verifier }
+}
+
+// Loader is an agent.Loader that pulls a Manifest and its agent spec from
+// Volcengine CR on Load, caching blobs on disk by digest.
+type Loader struct {
+	registry string
+	repo     string
+	tag      string
+
+	pinnedDigest string
+	cacheDir     string
+	verifier     SignatureVerifier
+}
+
+// NewLoader returns a Loader for repo:tag in registry, e.g.
+// NewLoader("cr-your-region.volces.com", "agents/planner", "v1.4.0").
+func NewLoader(registry, repo, tag string, opts ...Option) *Loader {
+	l := &Loader{registry: registry, repo: repo, tag: tag}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Load implements agent.Loader: it pulls the bundle's manifest and agent
+// spec, verifying and caching along the way, and delegates spec parsing and
+// tree construction to agent/loader so the resulting agent.Agent matches
+// what loader.NewYAMLLoader would build from the same spec on disk.
+func (l *Loader) Load(ctx context.Context) (agent.Agent, error) {
+	cache, err := newDiskCache(l.cacheDir)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := name.ParseReference(fmt.Sprintf("%s/%s:%s", l.registry, l.repo, l.tag))
+	if err != nil {
+		return nil, fmt.Errorf("volccr: parse reference: %w", err)
+	}
+
+	tokenSrc, err := newTokenSource(ref.Context().RegistryStr())
+	if err != nil {
+		return nil, err
+	}
+	token, err := tokenSrc.bearerToken(ctx, l.registry, l.repo)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := remote.Image(ref, remote.WithContext(ctx), remote.WithAuth(&transport.Bearer{Token: token}))
+	if err != nil {
+		return nil, fmt.Errorf("volccr: pull %s: %w", ref, err)
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return nil, fmt.Errorf("volccr: resolve digest: %w", err)
+	}
+	if l.pinnedDigest != "" && digest.String() != l.pinnedDigest {
+		return nil, fmt.Errorf("volccr: digest mismatch: pinned %s, got %s", l.pinnedDigest, digest.String())
+	}
+
+	manifestBytes, err := fetchLayer(cache, img, manifestMediaType)
+	if err != nil {
+		return nil, fmt.Errorf("volccr: fetch manifest: %w", err)
+	}
+	if l.verifier != nil {
+		if err := l.verifySignature(cache, img, digest.String(), manifestBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("volccr: decode manifest: %w", err)
+	}
+
+	specBytes, err := fetchLayer(cache, img, specMediaType)
+	if err != nil {
+		return nil, fmt.Errorf("volccr: fetch agent spec: %w", err)
+	}
+
+	spec, err := loader.ParseBytes(specBytes, fmt.Sprintf("%s/%s:%s!%s", l.registry, l.repo, l.tag, manifest.AgentSpecPath), "")
+	if err != nil {
+		return nil, err
+	}
+	return loader.Build(spec)
+}
+
+// verifySignature fetches the bundle's signature layer, if any pulled
+// alongside the manifest and spec, and runs it through l.verifier.
+func (l *Loader) verifySignature(cache *diskCache, img v1.Image, digest string, manifest []byte) error {
+	sig, ok, err := fetchOptionalLayer(cache, img, signatureMediaType)
+	if err != nil {
+		return fmt.Errorf("volccr: fetch signature: %w", err)
+	}
+	if !ok {
+		return errUnsignedArtifact
+	}
+	return l.verifier.Verify(digest, manifest, sig)
+}
+
+// fetchLayer returns the contents of the first layer in img whose media
+// type matches mediaType, serving it from cache by digest when present. It
+// errors if no such layer exists.
+func fetchLayer(cache *diskCache, img v1.Image, mediaType string) ([]byte, error) {
+	data, ok, err := fetchOptionalLayer(cache, img, mediaType)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("no layer with media type %s", mediaType)
+	}
+	return data, nil
+}
+
+// fetchOptionalLayer is fetchLayer but reports a missing layer as ok=false
+// instead of an error, for layers a bundle may omit (e.g. its signature).
+func fetchOptionalLayer(cache *diskCache, img v1.Image, mediaType string) ([]byte, bool, error) {
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, false, fmt.Errorf("list layers: %w", err)
+	}
+
+	for _, layer := range layers {
+		mt, err := layer.MediaType()
+		if err != nil || string(mt) != mediaType {
+			continue
+		}
+
+		digest, err := layer.Digest()
+		if err != nil {
+			return nil, false, fmt.Errorf("layer digest: %w", err)
+		}
+		if data, ok := cache.get(digest.String()); ok {
+			return data, true, nil
+		}
+
+		rc, err := layer.Uncompressed()
+		if err != nil {
+			return nil, false, fmt.Errorf("read layer %s: %w", digest, err)
+		}
+		defer rc.Close()
+
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			return nil, false, fmt.Errorf("read layer %s: %w", digest, err)
+		}
+		if err := cache.put(digest.String(), data); err != nil {
+			return nil, false, fmt.Errorf("cache layer %s: %w", digest, err)
+		}
+		return data, true, nil
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,21 @@
+package volccr
+
+// Manifest describes one agent bundle: the agent graph spec plus the tool
+// binaries or gRPC endpoints it depends on. It is stored as the layer with
+// media type manifestMediaType of an OCI artifact pushed to Volcengine
+// Container Registry.
+type Manifest struct {
+	SchemaVersion int    `json:"schema_version"`
+	AgentSpecPath string `json:"agent_spec_path"`
+
+	Tools []ToolRef `json:"tools,omitempty"`
+}
+
+// ToolRef points at a tool bundled alongside the agent spec, either as a
+// binary layer in the same artifact or as an already-deployed gRPC
+// endpoint (see tool/grpc).
+type ToolRef struct {
+	Name         string `json:"name"`
+	BinaryDigest string `json:"binary_digest,omitempty"`
+	GRPCEndpoint string `json:"grpc_endpoint,omitempty"`
+}
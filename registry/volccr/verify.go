@@ -0,0 +1,21 @@
+package volccr
+
+import "fmt"
+
+// SignatureVerifier validates a pulled manifest blob before it is trusted,
+// e.g. against cosign or an internal signing service. A Loader with no
+// SignatureVerifier configured skips verification.
+type SignatureVerifier interface {
+	Verify(digest string, manifest []byte, signature []byte) error
+}
+
+// verifierFunc adapts a plain function to SignatureVerifier.
+type verifierFunc func(digest string, manifest, signature []byte) error
+
+func (f verifierFunc) Verify(digest string, manifest, signature []byte) error {
+	return f(digest, manifest, signature)
+}
+
+// errUnsignedArtifact is returned when a Loader requires signatures but the
+// pulled artifact did not carry one.
+var errUnsignedArtifact = fmt.Errorf("volccr: artifact has no signature but a SignatureVerifier is configured")
@@ -0,0 +1,116 @@
+// Package grpc lets a tool.Tool be implemented out-of-process: a backend
+// registers its tools over gRPC (see ToolBackendServer in toolpb), and
+// NewRemoteTools dials it and returns local tool.Tool stand-ins that proxy
+// every call across the connection.
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/volcengine/veadk-go/tool/grpc/toolpb"
+	"google.golang.org/adk/tool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Config controls how NewRemoteTools connects to a tool backend.
+type Config struct {
+	// Target is the backend address, e.g. "localhost:7890" or a resolver
+	// target understood by grpc.NewClient.
+	Target string
+	// DialOptions overrides the default insecure transport credentials,
+	// e.g. to enable TLS against a remote-process backend.
+	DialOptions []grpc.DialOption
+}
+
+// NewRemoteTools dials cfg.Target, asks the backend to describe its tools,
+// and returns one tool.Tool per declaration. The connection is shared and
+// closed when ctx is done.
+func NewRemoteTools(ctx context.Context, cfg *Config) ([]tool.Tool, error) {
+	opts := cfg.DialOptions
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	// toolpb's messages aren't proto.Message, so the stock "proto" codec
+	// can't (de)serialize them; force the JSON codec that server.go also
+	// forces.
+	opts = append(opts, grpc.WithDefaultCallOptions(grpc.ForceCodec(toolpb.Codec{})))
+
+	conn, err := grpc.NewClient(cfg.Target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("grpc: dial %s: %w", cfg.Target, err)
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	client := toolpb.NewToolBackendClient(conn)
+	desc, err := client.Describe(ctx, &toolpb.DescribeRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("grpc: describe %s: %w", cfg.Target, err)
+	}
+
+	tools := make([]tool.Tool, 0, len(desc.Tools))
+	for _, d := range desc.Tools {
+		tools = append(tools, &remoteTool{client: client, decl: d})
+	}
+	return tools, nil
+}
+
+// remoteTool implements tool.Tool by shelling each call out to a
+// toolpb.ToolBackendClient.
+type remoteTool struct {
+	client toolpb.ToolBackendClient
+	decl   *toolpb.ToolDeclaration
+}
+
+func (t *remoteTool) Name() string        { return t.decl.Name }
+func (t *remoteTool) Description() string { return t.decl.Description }
+func (t *remoteTool) JSONSchema() string  { return t.decl.JsonSchema }
+
+// Call invokes the remote tool, waits for the stream to finish and returns
+// the last result message received; earlier partial results are not
+// merged into it. Callers that want partial results as they stream in
+// should use CallStream instead.
+func (t *remoteTool) Call(ctx context.Context, sessionID, userID string, args json.RawMessage) (json.RawMessage, error) {
+	var last json.RawMessage
+	err := t.CallStream(ctx, sessionID, userID, args, func(partial json.RawMessage) {
+		last = partial
+	})
+	return last, err
+}
+
+// CallStream invokes the remote tool, delivering each partial result to
+// onPartial as it arrives.
+func (t *remoteTool) CallStream(ctx context.Context, sessionID, userID string, args json.RawMessage, onPartial func(json.RawMessage)) error {
+	stream, err := t.client.CallTool(ctx, &toolpb.CallToolRequest{
+		ToolName:      t.decl.Name,
+		ArgumentsJson: string(args),
+		SessionId:     sessionID,
+		UserId:        userID,
+	})
+	if err != nil {
+		return fmt.Errorf("grpc: call %s: %w", t.decl.Name, err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("grpc: call %s: %w", t.decl.Name, err)
+		}
+		if resp.Error != "" {
+			return fmt.Errorf("grpc: %s: %s", t.decl.Name, resp.Error)
+		}
+		onPartial(json.RawMessage(resp.ResultJson))
+		if resp.Done {
+			return nil
+		}
+	}
+}
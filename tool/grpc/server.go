@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/volcengine/veadk-go/tool/grpc/toolpb"
+	"google.golang.org/adk/tool"
+	"google.golang.org/grpc"
+)
+
+// Server adapts a set of local tool.Tool implementations to the
+// ToolBackend gRPC service so they can be called from a separate process.
+type Server struct {
+	toolpb.UnimplementedToolBackendServer
+
+	tools map[string]tool.Tool
+}
+
+// NewServer returns a Server hosting tools, keyed by their Name().
+func NewServer(tools ...tool.Tool) *Server {
+	byName := make(map[string]tool.Tool, len(tools))
+	for _, t := range tools {
+		byName[t.(interface{ Name() string }).Name()] = t
+	}
+	return &Server{tools: byName}
+}
+
+// NewGRPCServer returns a *grpc.Server with the JSON codec toolpb's
+// messages require forced on, since they don't implement proto.Message and
+// so can't go through grpc's default "proto" codec. Backends should
+// construct their listener with this instead of grpc.NewServer directly.
+func NewGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+	opts = append([]grpc.ServerOption{grpc.ForceServerCodec(toolpb.Codec{})}, opts...)
+	return grpc.NewServer(opts...)
+}
+
+// Register registers s on grpcServer.
+func (s *Server) Register(grpcServer grpc.ServiceRegistrar) {
+	toolpb.RegisterToolBackendServer(grpcServer, s)
+}
+
+func (s *Server) Describe(ctx context.Context, req *toolpb.DescribeRequest) (*toolpb.DescribeResponse, error) {
+	decls := make([]*toolpb.ToolDeclaration, 0, len(s.tools))
+	for name, t := range s.tools {
+		describable, ok := t.(interface {
+			Description() string
+			JSONSchema() string
+		})
+		decl := &toolpb.ToolDeclaration{Name: name}
+		if ok {
+			decl.Description = describable.Description()
+			decl.JsonSchema = describable.JSONSchema()
+		}
+		decls = append(decls, decl)
+	}
+	return &toolpb.DescribeResponse{Tools: decls}, nil
+}
+
+func (s *Server) CallTool(req *toolpb.CallToolRequest, stream toolpb.ToolBackend_CallToolServer) error {
+	t, ok := s.tools[req.ToolName]
+	if !ok {
+		return stream.Send(&toolpb.CallToolResponse{Done: true, Error: "unknown tool: " + req.ToolName})
+	}
+
+	caller, ok := t.(interface {
+		Call(ctx context.Context, sessionID, userID string, args json.RawMessage) (json.RawMessage, error)
+	})
+	if !ok {
+		return stream.Send(&toolpb.CallToolResponse{Done: true, Error: "tool does not support remote calls: " + req.ToolName})
+	}
+
+	result, err := caller.Call(stream.Context(), req.SessionId, req.UserId, json.RawMessage(req.ArgumentsJson))
+	if err != nil {
+		return stream.Send(&toolpb.CallToolResponse{Done: true, Error: err.Error()})
+	}
+	return stream.Send(&toolpb.CallToolResponse{ResultJson: string(result), Done: true})
+}
@@ -0,0 +1,22 @@
+package toolpb
+
+import "encoding/json"
+
+// Codec marshals ToolBackend messages as JSON instead of protobuf wire
+// format. The structs in this package carry protobuf field-number tags for
+// documentation purposes only; they don't implement proto.Message, so they
+// can't go through grpc's default "proto" codec. Force this codec on both
+// the client and the server with grpc.ForceCodec / grpc.ForceServerCodec.
+type Codec struct{}
+
+func (Codec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (Codec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (Codec) Name() string {
+	return "json-tool-backend"
+}
@@ -0,0 +1,32 @@
+// Package toolpb mirrors the message shapes described in
+// ../toolbackend.proto by hand. It is not protoc output: the types below
+// don't implement proto.Message, which is why client.go/server.go force
+// Codec (JSON) instead of grpc's default "proto" codec. Keep this file in
+// sync with the .proto manually until a real protoc/buf generation step
+// replaces it.
+package toolpb
+
+type ToolDeclaration struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	JsonSchema  string `protobuf:"bytes,3,opt,name=json_schema,proto3" json:"json_schema,omitempty"`
+}
+
+type DescribeRequest struct{}
+
+type DescribeResponse struct {
+	Tools []*ToolDeclaration `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`
+}
+
+type CallToolRequest struct {
+	ToolName      string `protobuf:"bytes,1,opt,name=tool_name,proto3" json:"tool_name,omitempty"`
+	ArgumentsJson string `protobuf:"bytes,2,opt,name=arguments_json,proto3" json:"arguments_json,omitempty"`
+	SessionId     string `protobuf:"bytes,3,opt,name=session_id,proto3" json:"session_id,omitempty"`
+	UserId        string `protobuf:"bytes,4,opt,name=user_id,proto3" json:"user_id,omitempty"`
+}
+
+type CallToolResponse struct {
+	ResultJson string `protobuf:"bytes,1,opt,name=result_json,proto3" json:"result_json,omitempty"`
+	Done       bool   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Error      string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
@@ -0,0 +1,154 @@
+// Hand-written client/server stubs for the ToolBackend service described in
+// ../toolbackend.proto, shaped like protoc-gen-go-grpc output but not
+// produced by it — see toolbackend.pb.go for why. Keep in sync with the
+// .proto manually until a real generation step replaces it.
+package toolpb
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	ToolBackend_Describe_FullMethodName = "/veadk.tool.grpc.v1.ToolBackend/Describe"
+	ToolBackend_CallTool_FullMethodName = "/veadk.tool.grpc.v1.ToolBackend/CallTool"
+)
+
+// ToolBackendClient is the client API for ToolBackend service.
+type ToolBackendClient interface {
+	Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error)
+	CallTool(ctx context.Context, in *CallToolRequest, opts ...grpc.CallOption) (ToolBackend_CallToolClient, error)
+}
+
+type toolBackendClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewToolBackendClient returns a client for the ToolBackend service backed
+// by cc.
+func NewToolBackendClient(cc grpc.ClientConnInterface) ToolBackendClient {
+	return &toolBackendClient{cc: cc}
+}
+
+func (c *toolBackendClient) Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error) {
+	out := new(DescribeResponse)
+	if err := c.cc.Invoke(ctx, ToolBackend_Describe_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toolBackendClient) CallTool(ctx context.Context, in *CallToolRequest, opts ...grpc.CallOption) (ToolBackend_CallToolClient, error) {
+	stream, err := c.cc.(grpc.ClientConnInterface).NewStream(ctx, &ToolBackend_ServiceDesc.Streams[0], ToolBackend_CallTool_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &toolBackendCallToolClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// ToolBackend_CallToolClient is the client-side stream handle for CallTool.
+type ToolBackend_CallToolClient interface {
+	Recv() (*CallToolResponse, error)
+	grpc.ClientStream
+}
+
+type toolBackendCallToolClient struct {
+	grpc.ClientStream
+}
+
+func (x *toolBackendCallToolClient) Recv() (*CallToolResponse, error) {
+	m := new(CallToolResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ToolBackendServer is the server API for ToolBackend service.
+type ToolBackendServer interface {
+	Describe(context.Context, *DescribeRequest) (*DescribeResponse, error)
+	CallTool(*CallToolRequest, ToolBackend_CallToolServer) error
+}
+
+// UnimplementedToolBackendServer can be embedded to have forward compatible
+// implementations.
+type UnimplementedToolBackendServer struct{}
+
+func (UnimplementedToolBackendServer) Describe(context.Context, *DescribeRequest) (*DescribeResponse, error) {
+	return nil, grpcUnimplemented("Describe")
+}
+
+func (UnimplementedToolBackendServer) CallTool(*CallToolRequest, ToolBackend_CallToolServer) error {
+	return grpcUnimplemented("CallTool")
+}
+
+// ToolBackend_CallToolServer is the server-side stream handle for CallTool.
+type ToolBackend_CallToolServer interface {
+	Send(*CallToolResponse) error
+	grpc.ServerStream
+}
+
+type toolBackendCallToolServer struct {
+	grpc.ServerStream
+}
+
+func (x *toolBackendCallToolServer) Send(m *CallToolResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// RegisterToolBackendServer registers srv with s.
+func RegisterToolBackendServer(s grpc.ServiceRegistrar, srv ToolBackendServer) {
+	s.RegisterService(&ToolBackend_ServiceDesc, srv)
+}
+
+func toolBackendDescribeHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToolBackendServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: ToolBackend_Describe_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToolBackendServer).Describe(ctx, req.(*DescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func toolBackendCallToolHandler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CallToolRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ToolBackendServer).CallTool(m, &toolBackendCallToolServer{stream})
+}
+
+// ToolBackend_ServiceDesc is the grpc.ServiceDesc for the ToolBackend
+// service.
+var ToolBackend_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "veadk.tool.grpc.v1.ToolBackend",
+	HandlerType: (*ToolBackendServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Describe",
+			Handler:    toolBackendDescribeHandler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CallTool",
+			Handler:       toolBackendCallToolHandler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "toolbackend.proto",
+}
@@ -0,0 +1,19 @@
+// Package websearch is a reference ToolBackend implementation wrapping the
+// existing in-process web_search tool, demonstrating how to migrate a tool
+// from a compiled-in []tool.Tool entry to a standalone gRPC backend.
+package websearch
+
+import (
+	"github.com/volcengine/veadk-go/tool/builtin_tools/web_search"
+	"github.com/volcengine/veadk-go/tool/grpc"
+)
+
+// NewServer builds a grpc.Server hosting the web_search tool configured by
+// cfg, ready to Register on a *grpc.Server listener.
+func NewServer(cfg *web_search.Config) (*grpc.Server, error) {
+	t, err := web_search.NewWebSearchTool(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.NewServer(t), nil
+}